@@ -0,0 +1,98 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// cacheDir returns $XDG_CACHE_HOME/gh-summary/<sub>, falling back to
+// ~/.cache/gh-summary/<sub> when XDG_CACHE_HOME is unset.
+func cacheDir(sub string) (string, error) {
+	base := os.Getenv("XDG_CACHE_HOME")
+	if base == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		base = filepath.Join(home, ".cache")
+	}
+
+	dir := filepath.Join(base, "gh-summary", sub)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+
+	return dir, nil
+}
+
+// cachingTransport is an http.RoundTripper that serves GET responses from an
+// on-disk cache keyed by request URL, so repeated go-github calls over
+// overlapping date ranges don't re-hit the API.
+type cachingTransport struct {
+	next http.RoundTripper
+	dir  string
+}
+
+// newCachingTransport wraps next with an on-disk cache rooted at
+// $XDG_CACHE_HOME/gh-summary/http/. If the cache directory can't be created,
+// it falls back to next unmodified.
+func newCachingTransport(next http.RoundTripper) http.RoundTripper {
+	dir, err := cacheDir("http")
+	if err != nil {
+		return next
+	}
+
+	return &cachingTransport{next: next, dir: dir}
+}
+
+func (t *cachingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		return t.next.RoundTrip(req)
+	}
+
+	key := t.key(req)
+
+	if body, err := os.ReadFile(key); err == nil {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Status:     "200 OK (cached)",
+			Proto:      "HTTP/1.1",
+			Header:     http.Header{"Content-Type": []string{"application/json; charset=utf-8"}},
+			Body:       io.NopCloser(bytes.NewReader(body)),
+			Request:    req,
+		}, nil
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err == nil {
+			_ = os.WriteFile(key, body, 0o644)
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+		}
+	}
+
+	return resp, nil
+}
+
+// key derives a cache file path from the request URL plus headers that
+// affect the response (Authorization selects scope/rate-limit, Accept
+// selects the response's media type/shape).
+func (t *cachingTransport) key(req *http.Request) string {
+	h := sha256.New()
+	io.WriteString(h, req.URL.String())
+	io.WriteString(h, req.Header.Get("Accept"))
+	io.WriteString(h, req.Header.Get("Authorization"))
+
+	return filepath.Join(t.dir, hex.EncodeToString(h.Sum(nil))+".json")
+}
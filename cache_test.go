@@ -0,0 +1,38 @@
+package main
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestCachingTransportKey(t *testing.T) {
+	ct := &cachingTransport{dir: "/cache"}
+
+	req1 := mustGetRequest(t, "https://api.github.com/repos/a/b", "application/vnd.github+json", "Bearer one")
+	req2 := mustGetRequest(t, "https://api.github.com/repos/a/b", "application/vnd.github+json", "Bearer one")
+	req3 := mustGetRequest(t, "https://api.github.com/repos/a/b", "application/vnd.github+json", "Bearer two")
+	req4 := mustGetRequest(t, "https://api.github.com/repos/a/c", "application/vnd.github+json", "Bearer one")
+
+	if ct.key(req1) != ct.key(req2) {
+		t.Error("identical requests should produce the same cache key")
+	}
+	if ct.key(req1) == ct.key(req3) {
+		t.Error("requests with different Authorization should produce different cache keys")
+	}
+	if ct.key(req1) == ct.key(req4) {
+		t.Error("requests with different URLs should produce different cache keys")
+	}
+}
+
+func mustGetRequest(t *testing.T, url, accept, auth string) *http.Request {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatalf("building request: %v", err)
+	}
+	req.Header.Set("Accept", accept)
+	req.Header.Set("Authorization", auth)
+
+	return req
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/google/go-github/v59/github"
+	bolt "go.etcd.io/bbolt"
+	"golang.org/x/oauth2"
+)
+
+// eventsBucket stores one JSON-encoded []Activity per day, across all
+// categories, keyed by "<category>/<YYYY-MM-DD>".
+var eventsBucket = []byte("events")
+
+// Corpus is a local, BoltDB-backed store of a user's GitHub events, built by
+// walking their public event timeline once so that range queries over
+// overlapping dates don't have to re-issue search calls per day.
+type Corpus struct {
+	db *bolt.DB
+}
+
+// openCorpus opens (creating if needed) the corpus database for user under
+// $XDG_CACHE_HOME/gh-summary/corpus/<user>.db.
+func openCorpus(user string) (*Corpus, error) {
+	dir, err := cacheDir("corpus")
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := bolt.Open(filepath.Join(dir, user+".db"), 0o600, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(eventsBucket)
+		return err
+	}); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &Corpus{db: db}, nil
+}
+
+func (c *Corpus) Close() error {
+	return c.db.Close()
+}
+
+// Refresh walks client.Activity.ListEventsPerformedByUser for user, paginating
+// until events older than `since` are seen, and stores issue/PR/commit/comment
+// events bucketed by day so Range can answer queries without hitting the API.
+func (c *Corpus) Refresh(ctx context.Context, client *Client, user string, since time.Time) error {
+	byDay := map[string]map[string][]Activity{}
+
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		events, resp, err := client.ListEventsPerformedByUser(ctx, user, false, opts)
+		if err != nil {
+			return fmt.Errorf("listing events: %w", err)
+		}
+
+		done := false
+		for _, event := range events {
+			createdAt := event.GetCreatedAt().Time
+			if createdAt.Before(since) {
+				done = true
+				break
+			}
+
+			category, activity, ok := activityFromEvent(event)
+			if !ok {
+				continue
+			}
+
+			day := createdAt.Format("2006-01-02")
+			if byDay[day] == nil {
+				byDay[day] = map[string][]Activity{}
+			}
+			byDay[day][category] = append(byDay[day][category], activity)
+		}
+
+		if done || resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return c.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(eventsBucket)
+		for day, categories := range byDay {
+			for category, activities := range categories {
+				data, err := json.Marshal(activities)
+				if err != nil {
+					return err
+				}
+				if err := bucket.Put([]byte(category+"/"+day), data); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// Range returns every stored Activity for category between from and to
+// (inclusive), reading straight from the local store.
+func (c *Corpus) Range(category string, from, to time.Time) ([]Activity, error) {
+	var activities []Activity
+
+	err := c.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(eventsBucket)
+		for d := from; !d.After(to); d = d.Add(24 * time.Hour) {
+			data := bucket.Get([]byte(category + "/" + d.Format("2006-01-02")))
+			if data == nil {
+				continue
+			}
+
+			var day []Activity
+			if err := json.Unmarshal(data, &day); err != nil {
+				return err
+			}
+			activities = append(activities, day...)
+		}
+		return nil
+	})
+
+	return activities, err
+}
+
+// fetchRange answers a --from/--to query for user out of the local corpus,
+// refreshing it from the GitHub API first so the range is up to date. This
+// avoids issuing one search query per category per day, which is what makes
+// month- or year-long ranges practical under the search API's rate limit.
+func fetchRange(ctx context.Context, cfg configGetter, user string, from, to time.Time) (Response, error) {
+	client := cachedGitHubClient(ctx, cfg.Get("GITHUB_TOKEN"))
+
+	corpus, err := openCorpus(user)
+	if err != nil {
+		return Response{}, fmt.Errorf("opening corpus: %w", err)
+	}
+	defer corpus.Close()
+
+	if err := corpus.Refresh(ctx, client, user, from); err != nil {
+		return Response{}, fmt.Errorf("refreshing corpus: %w", err)
+	}
+
+	var response Response
+	var rangeErr error
+	for category, dst := range map[string]*[]Activity{
+		"issues_created":  &response.IssuesCreated,
+		"prs_reviewed":    &response.PRsReviewed,
+		"prs_merged":      &response.PRsMerged,
+		"commits_created": &response.CommitsCreated,
+		"comments":        &response.Comments,
+	} {
+		activities, err := corpus.Range(category, from, to)
+		if err != nil {
+			rangeErr = err
+			continue
+		}
+		*dst = tagSource(activities, "github")
+	}
+
+	return response, rangeErr
+}
+
+// cachedGitHubClient builds a client wired through the on-disk HTTP cache.
+// Unlike githubClient, this is safe to cache: fetchRange only ever asks the
+// corpus about days strictly before `from` was first refreshed, i.e. closed,
+// no-longer-changing days, so a cached response can't go stale mid-range.
+func cachedGitHubClient(ctx context.Context, token string) *Client {
+	ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token})
+	tc := oauth2.NewClient(ctx, ts)
+	tc.Transport = newCachingTransport(tc.Transport)
+
+	return NewClient(github.NewClient(tc))
+}
+
+// activityFromEvent maps a GitHub event to the category it belongs to
+// ("issues_created", "prs_merged", "commits_created", "comments") and the
+// Activity it represents, mirroring the categories fetched per-day today.
+func activityFromEvent(event *github.Event) (category string, activity Activity, ok bool) {
+	switch event.GetType() {
+	case "IssuesEvent":
+		var payload github.IssuesEvent
+		if err := json.Unmarshal(event.GetRawPayload(), &payload); err != nil || payload.GetAction() != "opened" {
+			return "", Activity{}, false
+		}
+		return "issues_created", Activity{Title: payload.Issue.GetTitle(), URL: payload.Issue.GetHTMLURL()}, true
+
+	case "PullRequestEvent":
+		var payload github.PullRequestEvent
+		if err := json.Unmarshal(event.GetRawPayload(), &payload); err != nil || !payload.GetPullRequest().GetMerged() {
+			return "", Activity{}, false
+		}
+		return "prs_merged", Activity{Title: payload.PullRequest.GetTitle(), URL: payload.PullRequest.GetHTMLURL()}, true
+
+	case "PullRequestReviewEvent":
+		var payload github.PullRequestReviewEvent
+		if err := json.Unmarshal(event.GetRawPayload(), &payload); err != nil {
+			return "", Activity{}, false
+		}
+		return "prs_reviewed", Activity{Title: payload.PullRequest.GetTitle(), URL: payload.PullRequest.GetHTMLURL()}, true
+
+	case "PushEvent":
+		var payload github.PushEvent
+		if err := json.Unmarshal(event.GetRawPayload(), &payload); err != nil || len(payload.Commits) == 0 {
+			return "", Activity{}, false
+		}
+		commit := payload.Commits[len(payload.Commits)-1]
+		return "commits_created", Activity{Title: commit.GetMessage(), URL: commit.GetURL()}, true
+
+	case "IssueCommentEvent":
+		var payload github.IssueCommentEvent
+		if err := json.Unmarshal(event.GetRawPayload(), &payload); err != nil {
+			return "", Activity{}, false
+		}
+		return "comments", Activity{Title: payload.Issue.GetTitle(), URL: payload.Comment.GetHTMLURL()}, true
+
+	default:
+		return "", Activity{}, false
+	}
+}
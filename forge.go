@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Forge abstracts a code-forge (GitHub, GitLab, Gerrit, Forgejo/Gitea, ...)
+// so the fetch subcommand can aggregate activity across more than one of them.
+type Forge interface {
+	// Name identifies the forge for the Activity.Source field, e.g. "github", "gitlab".
+	Name() string
+	FetchIssuesCreated(ctx context.Context, user string, date time.Time) []Activity
+	FetchPRsReviewed(ctx context.Context, user string, date time.Time) []Activity
+	FetchPRsMerged(ctx context.Context, user string, date time.Time) []Activity
+	FetchCommitsCreated(ctx context.Context, user string, date time.Time) []Activity
+	FetchComments(ctx context.Context, user string, date time.Time) []Activity
+}
+
+// parseForgeSpec turns a --forge flag value into a forge name and an optional
+// base URL, e.g. "gerrit:https://gerrit.example.com" -> ("gerrit", "https://gerrit.example.com").
+func parseForgeSpec(spec string) (name string, baseURL string) {
+	name, baseURL, found := strings.Cut(spec, ":")
+	if !found {
+		return name, ""
+	}
+	return name, baseURL
+}
+
+// newForge builds a Forge from a --forge flag value and the tokens configured
+// for it, looking up "<NAME>_TOKEN" style config for anything beyond GitHub.
+func newForge(ctx context.Context, spec string, cfg configGetter) (Forge, error) {
+	name, baseURL := parseForgeSpec(spec)
+
+	switch name {
+	case "github":
+		return newGitHubForge(ctx, cfg.Get("GITHUB_TOKEN")), nil
+	case "gitlab":
+		return newGitLabForge(baseURL, cfg.Get("GITLAB_TOKEN"))
+	case "gerrit":
+		if baseURL == "" {
+			return nil, fmt.Errorf("gerrit forge requires a URL, e.g. --forge=gerrit:https://gerrit.example.com")
+		}
+		return newGerritForge(baseURL, cfg.Get("GERRIT_TOKEN")), nil
+	case "forgejo":
+		if baseURL == "" {
+			return nil, fmt.Errorf("forgejo forge requires a URL, e.g. --forge=forgejo:https://codeberg.org")
+		}
+		return newForgejoForge(baseURL, cfg.Get("FORGEJO_TOKEN"))
+	default:
+		return nil, fmt.Errorf("unknown forge %q", name)
+	}
+}
+
+// configGetter is the subset of gofr.Context's Config that newForge needs,
+// kept narrow so it can be satisfied directly by app.Config or c.Context in tests.
+type configGetter interface {
+	Get(key string) string
+}
+
+// fetchAll runs every fetch method of every forge concurrently and merges
+// the results into a single Response, tagging each Activity with its forge.
+func fetchAll(ctx context.Context, forges []Forge, user string, date time.Time) Response {
+	type bucket struct {
+		issuesCreated  []Activity
+		prsReviewed    []Activity
+		prsMerged      []Activity
+		commitsCreated []Activity
+		comments       []Activity
+	}
+
+	results := make(chan bucket, len(forges))
+
+	for _, f := range forges {
+		f := f
+		go func() {
+			results <- bucket{
+				issuesCreated:  tagSource(f.FetchIssuesCreated(ctx, user, date), f.Name()),
+				prsReviewed:    tagSource(f.FetchPRsReviewed(ctx, user, date), f.Name()),
+				prsMerged:      tagSource(f.FetchPRsMerged(ctx, user, date), f.Name()),
+				commitsCreated: tagSource(f.FetchCommitsCreated(ctx, user, date), f.Name()),
+				comments:       tagSource(f.FetchComments(ctx, user, date), f.Name()),
+			}
+		}()
+	}
+
+	var response Response
+	for range forges {
+		b := <-results
+		response.IssuesCreated = append(response.IssuesCreated, b.issuesCreated...)
+		response.PRsReviewed = append(response.PRsReviewed, b.prsReviewed...)
+		response.PRsMerged = append(response.PRsMerged, b.prsMerged...)
+		response.CommitsCreated = append(response.CommitsCreated, b.commitsCreated...)
+		response.Comments = append(response.Comments, b.comments...)
+	}
+
+	return response
+}
+
+func tagSource(activities []Activity, source string) []Activity {
+	for i := range activities {
+		activities[i].Source = source
+	}
+	return activities
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo"
+)
+
+// ForgejoForge implements Forge against a Forgejo or Gitea instance.
+type ForgejoForge struct {
+	client *forgejo.Client
+}
+
+func newForgejoForge(baseURL, token string) (*ForgejoForge, error) {
+	client, err := forgejo.NewClient(baseURL, forgejo.SetToken(token))
+	if err != nil {
+		return nil, fmt.Errorf("creating Forgejo client: %w", err)
+	}
+
+	return &ForgejoForge{client: client}, nil
+}
+
+func (f *ForgejoForge) Name() string {
+	return "forgejo"
+}
+
+func (f *ForgejoForge) FetchIssuesCreated(ctx context.Context, user string, date time.Time) []Activity {
+	issues, _, err := f.client.ListIssues(forgejo.ListIssueOption{
+		CreatedBy: user,
+		Since:     date,
+		Before:    date.Add(24 * time.Hour),
+		Type:      forgejo.IssueTypeIssue,
+	})
+	if err != nil {
+		fmt.Printf("Error fetching Forgejo issues: %v\n", err)
+		return nil
+	}
+
+	var activities []Activity
+	for _, issue := range issues {
+		activities = append(activities, Activity{Title: issue.Title, URL: issue.HTMLURL})
+	}
+
+	return activities
+}
+
+// FetchPRsReviewed is a no-op: the Forgejo/Gitea API has no cross-repo
+// "reviewed by" search (ListIssueOption has no such filter), so there's no
+// way to answer this without enumerating every pull request individually.
+func (f *ForgejoForge) FetchPRsReviewed(ctx context.Context, user string, date time.Time) []Activity {
+	return nil
+}
+
+func (f *ForgejoForge) FetchPRsMerged(ctx context.Context, user string, date time.Time) []Activity {
+	prs, _, err := f.client.ListIssues(forgejo.ListIssueOption{
+		CreatedBy: user,
+		State:     forgejo.StateClosed,
+		Since:     date,
+		Before:    date.Add(24 * time.Hour),
+		Type:      forgejo.IssueTypePull,
+	})
+	if err != nil {
+		fmt.Printf("Error fetching Forgejo merged PRs: %v\n", err)
+		return nil
+	}
+
+	var activities []Activity
+	for _, pr := range prs {
+		if pr.PullRequest == nil || !pr.PullRequest.HasMerged {
+			continue
+		}
+		activities = append(activities, Activity{Title: pr.Title, URL: pr.HTMLURL})
+	}
+
+	return activities
+}
+
+// FetchCommitsCreated lists every commit of every one of the user's repos
+// and keeps the ones authored by user on the given day client-side:
+// ListCommitOptions has no Author/Since/Until fields to push this down to
+// the server.
+func (f *ForgejoForge) FetchCommitsCreated(ctx context.Context, user string, date time.Time) []Activity {
+	repos, _, err := f.client.ListMyRepos(forgejo.ListReposOptions{})
+	if err != nil {
+		fmt.Printf("Error fetching Forgejo repositories: %v\n", err)
+		return nil
+	}
+
+	until := date.Add(24 * time.Hour)
+
+	var activities []Activity
+	for _, repo := range repos {
+		commits, _, err := f.client.ListRepoCommits(repo.Owner.UserName, repo.Name, forgejo.ListCommitOptions{})
+		if err != nil {
+			continue
+		}
+
+		for _, commit := range commits {
+			if commit.Author == nil || commit.Author.UserName != user {
+				continue
+			}
+
+			when, err := time.Parse(time.RFC3339, commit.RepoCommit.Author.Date)
+			if err != nil || when.Before(date) || !when.Before(until) {
+				continue
+			}
+
+			activities = append(activities, Activity{
+				Title: commit.RepoCommit.Message,
+				URL:   commit.HTMLURL,
+			})
+		}
+	}
+
+	return activities
+}
+
+func (f *ForgejoForge) FetchComments(ctx context.Context, user string, date time.Time) []Activity {
+	// The Forgejo/Gitea API has no cross-repo "commented on" search endpoint yet.
+	return nil
+}
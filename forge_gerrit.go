@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// gerritXSSIPrefix is prepended to every Gerrit REST response to defend
+// against cross-site script inclusion; it must be stripped before unmarshaling.
+// See https://gerrit-review.googlesource.com/Documentation/rest-api.html#output.
+const gerritXSSIPrefix = ")]}'"
+
+// GerritForge implements Forge against a Gerrit instance's REST API.
+type GerritForge struct {
+	baseURL string
+	token   string
+	http    *http.Client
+}
+
+func newGerritForge(baseURL, token string) *GerritForge {
+	return &GerritForge{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		token:   token,
+		http:    &http.Client{},
+	}
+}
+
+func (f *GerritForge) Name() string {
+	return "gerrit"
+}
+
+type gerritChange struct {
+	ChangeID string `json:"change_id"`
+	Subject  string `json:"subject"`
+	Number   int    `json:"_number"`
+	Status   string `json:"status"`
+}
+
+func (f *GerritForge) queryChanges(ctx context.Context, query string) []gerritChange {
+	url := fmt.Sprintf("%s/changes/?q=%s", f.baseURL, query)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		fmt.Printf("Error building Gerrit request: %v\n", err)
+		return nil
+	}
+	if f.token != "" {
+		req.Header.Set("Authorization", "Bearer "+f.token)
+	}
+
+	resp, err := f.http.Do(req)
+	if err != nil {
+		fmt.Printf("Error querying Gerrit: %v\n", err)
+		return nil
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		fmt.Printf("Error reading Gerrit response: %v\n", err)
+		return nil
+	}
+
+	// Strip the XSSI-defeating magic prefix line before decoding.
+	if idx := strings.IndexByte(string(body), '\n'); idx >= 0 {
+		body = body[idx+1:]
+	}
+
+	var changes []gerritChange
+	if err := json.Unmarshal(body, &changes); err != nil {
+		fmt.Printf("Error decoding Gerrit response: %v\n", err)
+		return nil
+	}
+
+	return changes
+}
+
+func (f *GerritForge) changeURL(number int) string {
+	return fmt.Sprintf("%s/c/+/%d", f.baseURL, number)
+}
+
+func (f *GerritForge) FetchIssuesCreated(ctx context.Context, user string, date time.Time) []Activity {
+	// Gerrit has no separate issue tracker; issues map to changes here.
+	return nil
+}
+
+func (f *GerritForge) FetchPRsReviewed(ctx context.Context, user string, date time.Time) []Activity {
+	query := fmt.Sprintf("reviewer:%s+after:%s", user, date.Format("2006-01-02"))
+	changes := f.queryChanges(ctx, query)
+
+	var activities []Activity
+	for _, c := range changes {
+		activities = append(activities, Activity{Title: c.Subject, URL: f.changeURL(c.Number)})
+	}
+
+	return activities
+}
+
+func (f *GerritForge) FetchPRsMerged(ctx context.Context, user string, date time.Time) []Activity {
+	query := fmt.Sprintf("owner:%s+after:%s+status:merged", user, date.Format("2006-01-02"))
+	changes := f.queryChanges(ctx, query)
+
+	var activities []Activity
+	for _, c := range changes {
+		activities = append(activities, Activity{Title: c.Subject, URL: f.changeURL(c.Number)})
+	}
+
+	return activities
+}
+
+func (f *GerritForge) FetchCommitsCreated(ctx context.Context, user string, date time.Time) []Activity {
+	query := fmt.Sprintf("owner:%s+after:%s", user, date.Format("2006-01-02"))
+	changes := f.queryChanges(ctx, query)
+
+	var activities []Activity
+	for _, c := range changes {
+		activities = append(activities, Activity{Title: c.Subject, URL: f.changeURL(c.Number)})
+	}
+
+	return activities
+}
+
+func (f *GerritForge) FetchComments(ctx context.Context, user string, date time.Time) []Activity {
+	query := fmt.Sprintf("commentby:%s+after:%s", user, date.Format("2006-01-02"))
+	changes := f.queryChanges(ctx, query)
+
+	var activities []Activity
+	for _, c := range changes {
+		activities = append(activities, Activity{Title: c.Subject, URL: f.changeURL(c.Number)})
+	}
+
+	return activities
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v59/github"
+	"golang.org/x/oauth2"
+)
+
+// GitHubForge implements Forge against the GitHub REST/Search API.
+type GitHubForge struct {
+	client *Client
+}
+
+func newGitHubForge(ctx context.Context, token string) *GitHubForge {
+	return &GitHubForge{client: githubClient(ctx, token)}
+}
+
+// githubClient builds an authenticated, rate-limit-aware client, shared by
+// the GitHubForge and group mode. It deliberately does NOT go through the
+// on-disk HTTP cache: a plain `fetch --date <today>` call targets an open,
+// still-changing day, and an uninvalidated cache would keep serving the
+// first run's stale results forever. Only the --from/--to corpus path
+// (corpus.go) opts into caching, where entries are keyed by a closed day.
+func githubClient(ctx context.Context, token string) *Client {
+	ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token})
+	tc := oauth2.NewClient(ctx, ts)
+
+	return NewClient(github.NewClient(tc))
+}
+
+func (f *GitHubForge) Name() string {
+	return "github"
+}
+
+func (f *GitHubForge) FetchIssuesCreated(ctx context.Context, user string, date time.Time) []Activity {
+	opts := &github.SearchOptions{Sort: "created", Order: "desc"}
+	query := fmt.Sprintf("author:%s type:issue created:%s", user, date.Format("2006-01-02"))
+	results, err := f.client.SearchIssues(ctx, query, opts)
+	if err != nil {
+		fmt.Printf("Error fetching issues: %v\n", err)
+		return nil
+	}
+
+	var activities []Activity
+	for _, issue := range results.Issues {
+		activities = append(activities, Activity{
+			Title: issue.GetTitle(),
+			URL:   issue.GetHTMLURL(),
+		})
+	}
+
+	return activities
+}
+
+func (f *GitHubForge) FetchPRsReviewed(ctx context.Context, user string, date time.Time) []Activity {
+	opts := &github.SearchOptions{Sort: "updated", Order: "desc"}
+	query := fmt.Sprintf("reviewed-by:%s type:pr updated:%s", user, date.Format("2006-01-02"))
+	results, err := f.client.SearchIssues(ctx, query, opts)
+	if err != nil {
+		fmt.Printf("Error fetching PRs reviewed: %v\n", err)
+		return nil
+	}
+
+	var activities []Activity
+	for _, pr := range results.Issues {
+		activities = append(activities, Activity{
+			Title: pr.GetTitle(),
+			URL:   pr.GetHTMLURL(),
+		})
+	}
+
+	return activities
+}
+
+func (f *GitHubForge) FetchPRsMerged(ctx context.Context, user string, date time.Time) []Activity {
+	opts := &github.SearchOptions{Sort: "updated", Order: "desc"}
+	query := fmt.Sprintf("author:%s type:pr is:merged updated:%s", user, date.Format("2006-01-02"))
+	results, err := f.client.SearchIssues(ctx, query, opts)
+	if err != nil {
+		fmt.Printf("Error fetching merged PRs: %v\n", err)
+		return nil
+	}
+
+	var activities []Activity
+	for _, pr := range results.Issues {
+		owner, repo, ok := ownerRepoFromIssue(pr)
+
+		status := StatusMerged
+		if ok {
+			if sha, err := mergeCommitSHA(ctx, f.client, owner, repo, pr.GetNumber()); err == nil && sha != "" {
+				status = releaseStatus(ctx, owner, repo, sha)
+			}
+		}
+
+		activities = append(activities, Activity{
+			Title:       pr.GetTitle(),
+			URL:         pr.GetHTMLURL(),
+			Status:      status,
+			StatusClass: statusClass(status),
+		})
+	}
+
+	return activities
+}
+
+func (f *GitHubForge) FetchCommitsCreated(ctx context.Context, user string, date time.Time) []Activity {
+	repos, err := f.client.ListRepositories(ctx, user, nil)
+	if err != nil {
+		fmt.Printf("Error fetching repositories: %v\n", err)
+		return nil
+	}
+
+	var activities []Activity
+	for _, repo := range repos {
+		owner, name := repo.GetOwner().GetLogin(), repo.GetName()
+
+		commits, err := f.client.ListCommits(ctx, owner, name, &github.CommitsListOptions{
+			Author: user,
+			Since:  date,
+			Until:  date.Add(24 * time.Hour),
+		})
+
+		if err != nil {
+			continue
+		}
+
+		for _, commit := range commits {
+			status := releaseStatus(ctx, owner, name, commit.GetSHA())
+
+			activities = append(activities, Activity{
+				Title:       commit.GetCommit().GetMessage(),
+				URL:         commit.GetHTMLURL(),
+				Status:      status,
+				StatusClass: statusClass(status),
+			})
+		}
+	}
+
+	return activities
+}
+
+// ownerRepoFromIssue extracts "owner", "repo" out of a search result's
+// RepositoryURL, e.g. "https://api.github.com/repos/owner/repo".
+func ownerRepoFromIssue(issue *github.Issue) (owner, repo string, ok bool) {
+	parts := strings.Split(issue.GetRepositoryURL(), "/")
+	if len(parts) < 2 {
+		return "", "", false
+	}
+	return parts[len(parts)-2], parts[len(parts)-1], true
+}
+
+func (f *GitHubForge) FetchComments(ctx context.Context, user string, date time.Time) []Activity {
+	opts := &github.SearchOptions{Sort: "updated", Order: "desc"}
+	query := fmt.Sprintf("commenter:%s updated:%s", user, date.Format("2006-01-02"))
+	results, err := f.client.SearchIssues(ctx, query, opts)
+	if err != nil {
+		fmt.Printf("Error fetching comments: %v\n", err)
+		return nil
+	}
+
+	var activities []Activity
+	for _, issue := range results.Issues {
+		activities = append(activities, Activity{
+			Title: issue.GetTitle(),
+			URL:   issue.GetHTMLURL(),
+		})
+	}
+
+	return activities
+}
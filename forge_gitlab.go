@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+// GitLabForge implements Forge against the GitLab REST API, either gitlab.com
+// or a self-hosted instance when baseURL is set.
+type GitLabForge struct {
+	client *gitlab.Client
+}
+
+func newGitLabForge(baseURL, token string) (*GitLabForge, error) {
+	var opts []gitlab.ClientOptionFunc
+	if baseURL != "" {
+		opts = append(opts, gitlab.WithBaseURL(baseURL))
+	}
+
+	client, err := gitlab.NewClient(token, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("creating GitLab client: %w", err)
+	}
+
+	return &GitLabForge{client: client}, nil
+}
+
+func (f *GitLabForge) Name() string {
+	return "gitlab"
+}
+
+func (f *GitLabForge) FetchIssuesCreated(ctx context.Context, user string, date time.Time) []Activity {
+	opts := &gitlab.ListIssuesOptions{
+		AuthorUsername: &user,
+		CreatedAfter:   gitlab.Ptr(date),
+		CreatedBefore:  gitlab.Ptr(date.Add(24 * time.Hour)),
+	}
+
+	issues, _, err := f.client.Issues.ListIssues(opts, gitlab.WithContext(ctx))
+	if err != nil {
+		fmt.Printf("Error fetching GitLab issues: %v\n", err)
+		return nil
+	}
+
+	var activities []Activity
+	for _, issue := range issues {
+		activities = append(activities, Activity{Title: issue.Title, URL: issue.WebURL})
+	}
+
+	return activities
+}
+
+func (f *GitLabForge) FetchPRsReviewed(ctx context.Context, user string, date time.Time) []Activity {
+	opts := &gitlab.ListMergeRequestsOptions{
+		ReviewerUsername: &user,
+		UpdatedAfter:     gitlab.Ptr(date),
+		UpdatedBefore:    gitlab.Ptr(date.Add(24 * time.Hour)),
+	}
+
+	mrs, _, err := f.client.MergeRequests.ListMergeRequests(opts, gitlab.WithContext(ctx))
+	if err != nil {
+		fmt.Printf("Error fetching GitLab merge requests reviewed: %v\n", err)
+		return nil
+	}
+
+	var activities []Activity
+	for _, mr := range mrs {
+		activities = append(activities, Activity{Title: mr.Title, URL: mr.WebURL})
+	}
+
+	return activities
+}
+
+func (f *GitLabForge) FetchPRsMerged(ctx context.Context, user string, date time.Time) []Activity {
+	merged := "merged"
+	opts := &gitlab.ListMergeRequestsOptions{
+		AuthorUsername: &user,
+		State:          &merged,
+		UpdatedAfter:   gitlab.Ptr(date),
+		UpdatedBefore:  gitlab.Ptr(date.Add(24 * time.Hour)),
+	}
+
+	mrs, _, err := f.client.MergeRequests.ListMergeRequests(opts, gitlab.WithContext(ctx))
+	if err != nil {
+		fmt.Printf("Error fetching GitLab merged merge requests: %v\n", err)
+		return nil
+	}
+
+	var activities []Activity
+	for _, mr := range mrs {
+		activities = append(activities, Activity{Title: mr.Title, URL: mr.WebURL})
+	}
+
+	return activities
+}
+
+func (f *GitLabForge) FetchCommitsCreated(ctx context.Context, user string, date time.Time) []Activity {
+	projects, _, err := f.client.Projects.ListUserProjects(user, &gitlab.ListProjectsOptions{}, gitlab.WithContext(ctx))
+	if err != nil {
+		fmt.Printf("Error fetching GitLab projects: %v\n", err)
+		return nil
+	}
+
+	var activities []Activity
+	for _, project := range projects {
+		// Author is GitLab's free-text author search, matched against commit
+		// name/email; there's no way to filter by login server-side, since
+		// commit.AuthorName is a free-text display name, not a username.
+		commits, _, err := f.client.Commits.ListCommits(project.ID, &gitlab.ListCommitsOptions{
+			Author: gitlab.Ptr(user),
+			Since:  gitlab.Ptr(date),
+			Until:  gitlab.Ptr(date.Add(24 * time.Hour)),
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			continue
+		}
+
+		for _, commit := range commits {
+			activities = append(activities, Activity{
+				Title: commit.Title,
+				URL:   commit.WebURL,
+			})
+		}
+	}
+
+	return activities
+}
+
+func (f *GitLabForge) FetchComments(ctx context.Context, user string, date time.Time) []Activity {
+	// GitLab has no cross-project "commented on" search; notes are scoped to
+	// a project, so this is left as a no-op until a project filter exists.
+	return nil
+}
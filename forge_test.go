@@ -0,0 +1,23 @@
+package main
+
+import "testing"
+
+func TestParseForgeSpec(t *testing.T) {
+	cases := []struct {
+		spec     string
+		wantName string
+		wantURL  string
+	}{
+		{"github", "github", ""},
+		{"gitlab", "gitlab", ""},
+		{"gerrit:https://gerrit.example.com", "gerrit", "https://gerrit.example.com"},
+		{"forgejo:https://codeberg.org", "forgejo", "https://codeberg.org"},
+	}
+
+	for _, c := range cases {
+		name, baseURL := parseForgeSpec(c.spec)
+		if name != c.wantName || baseURL != c.wantURL {
+			t.Errorf("parseForgeSpec(%q) = (%q, %q), want (%q, %q)", c.spec, name, baseURL, c.wantName, c.wantURL)
+		}
+	}
+}
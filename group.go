@@ -0,0 +1,214 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GroupResponse aggregates per-user Responses for a team or org, plus
+// summary statistics that don't make sense for a single person: who shipped
+// the most, how long issues stayed open, and how fast reviews turned around.
+type GroupResponse struct {
+	Users           map[string]Response `json:"users"`
+	TopMergers      []ContributorCount  `json:"top_mergers"`
+	IssueCloseTimes Histogram           `json:"issue_close_times"`
+	ReviewTurnaround Histogram          `json:"review_turnaround"`
+}
+
+// ContributorCount pairs a user with a count, used for "top-N by merged PRs".
+type ContributorCount struct {
+	User  string `json:"user"`
+	Count int    `json:"count"`
+}
+
+// Histogram buckets a set of durations, e.g. "issue open -> closed" or
+// "review requested -> first review", into named ranges.
+type Histogram struct {
+	Buckets []HistogramBucket `json:"buckets"`
+}
+
+// HistogramBucket counts how many samples fell within [Label, next bucket).
+type HistogramBucket struct {
+	Label string `json:"label"`
+	Count int    `json:"count"`
+}
+
+// durationBuckets are the edges used by both issue-close-latency and
+// PR-review-turnaround histograms: under an hour, under a day, under a week,
+// under a month, and everything beyond.
+var durationBuckets = []struct {
+	label string
+	under time.Duration
+}{
+	{"<1h", time.Hour},
+	{"<1d", 24 * time.Hour},
+	{"<1w", 7 * 24 * time.Hour},
+	{"<30d", 30 * 24 * time.Hour},
+	{">=30d", 0},
+}
+
+func bucketDurations(durations []time.Duration) Histogram {
+	counts := make([]int, len(durationBuckets))
+
+	for _, d := range durations {
+		for i, b := range durationBuckets {
+			if b.under == 0 || d < b.under {
+				counts[i]++
+				break
+			}
+		}
+	}
+
+	var h Histogram
+	for i, b := range durationBuckets {
+		h.Buckets = append(h.Buckets, HistogramBucket{Label: b.label, Count: counts[i]})
+	}
+
+	return h
+}
+
+// fetchGroup fans out fetchAll per user (reusing the concurrent pattern used
+// for a single user's categories) and folds the results into a GroupResponse.
+// Every user shares the single client passed in, so the whole org fans out
+// against one rate-limit budget rather than one per member.
+// repoFilter, when non-empty, keeps only activities whose URL mentions that
+// repo or topic, e.g. "storage" to scope down to "what did the storage team ship".
+func fetchGroup(ctx context.Context, client *Client, users []string, date time.Time, repoFilter string) (GroupResponse, error) {
+	type userResult struct {
+		user     string
+		response Response
+	}
+
+	results := make(chan userResult, len(users))
+
+	forge := &GitHubForge{client: client}
+
+	for _, user := range users {
+		user := user
+		go func() {
+			response := fetchAll(ctx, []Forge{forge}, user, date)
+			if repoFilter != "" {
+				response = filterResponse(response, repoFilter)
+			}
+			results <- userResult{user: user, response: response}
+		}()
+	}
+
+	group := GroupResponse{Users: map[string]Response{}}
+
+	var mergeCounts []ContributorCount
+	var closeTimes, reviewTimes []time.Duration
+
+	for range users {
+		r := <-results
+		group.Users[r.user] = r.response
+
+		mergeCounts = append(mergeCounts, ContributorCount{User: r.user, Count: len(r.response.PRsMerged)})
+
+		closeTimes = append(closeTimes, issueCloseLatencies(ctx, client, r.user, r.response.IssuesCreated)...)
+		reviewTimes = append(reviewTimes, reviewTurnarounds(ctx, client, r.user, r.response.PRsReviewed)...)
+	}
+
+	sort.Slice(mergeCounts, func(i, j int) bool { return mergeCounts[i].Count > mergeCounts[j].Count })
+	group.TopMergers = mergeCounts
+	group.IssueCloseTimes = bucketDurations(closeTimes)
+	group.ReviewTurnaround = bucketDurations(reviewTimes)
+
+	return group, nil
+}
+
+// filterResponse drops every Activity whose URL doesn't contain filter,
+// letting the group subcommand scope down to a repo or topic.
+func filterResponse(resp Response, filter string) Response {
+	keep := func(activities []Activity) []Activity {
+		var kept []Activity
+		for _, a := range activities {
+			if strings.Contains(a.URL, filter) {
+				kept = append(kept, a)
+			}
+		}
+		return kept
+	}
+
+	return Response{
+		IssuesCreated:  keep(resp.IssuesCreated),
+		PRsReviewed:    keep(resp.PRsReviewed),
+		PRsMerged:      keep(resp.PRsMerged),
+		CommitsCreated: keep(resp.CommitsCreated),
+		Comments:       keep(resp.Comments),
+	}
+}
+
+// issueCloseLatencies looks up CreatedAt/ClosedAt for each closed issue in
+// activities and returns the time each one stayed open.
+func issueCloseLatencies(ctx context.Context, client *Client, user string, activities []Activity) []time.Duration {
+	var durations []time.Duration
+
+	for _, a := range activities {
+		owner, repo, number, ok := parseIssueURL(a.URL)
+		if !ok {
+			continue
+		}
+
+		issue, err := client.GetIssue(ctx, owner, repo, number)
+		if err != nil || issue.ClosedAt == nil {
+			continue
+		}
+
+		durations = append(durations, issue.GetClosedAt().Time.Sub(issue.GetCreatedAt().Time))
+	}
+
+	return durations
+}
+
+// reviewTurnarounds approximates "review-request to first review" as the PR's
+// age at the time it was found reviewed, since go-github's search results
+// don't carry the original review-request timestamp.
+func reviewTurnarounds(ctx context.Context, client *Client, user string, activities []Activity) []time.Duration {
+	var durations []time.Duration
+
+	for _, a := range activities {
+		owner, repo, number, ok := parseIssueURL(a.URL)
+		if !ok {
+			continue
+		}
+
+		reviews, err := client.ListReviews(ctx, owner, repo, number, nil)
+		if err != nil || len(reviews) == 0 {
+			continue
+		}
+
+		pr, err := client.GetPullRequest(ctx, owner, repo, number)
+		if err != nil {
+			continue
+		}
+
+		durations = append(durations, reviews[0].GetSubmittedAt().Time.Sub(pr.GetCreatedAt().Time))
+	}
+
+	return durations
+}
+
+// parseIssueURL extracts owner, repo, and number from an
+// https://github.com/owner/repo/issues/N or .../pull/N URL.
+func parseIssueURL(url string) (owner, repo string, number int, ok bool) {
+	rest := strings.TrimPrefix(url, "https://github.com/")
+	if rest == url {
+		return "", "", 0, false
+	}
+
+	parts := strings.Split(rest, "/")
+	if len(parts) != 4 {
+		return "", "", 0, false
+	}
+
+	number, err := strconv.Atoi(parts[3])
+	if err != nil {
+		return "", "", 0, false
+	}
+
+	return parts[0], parts[1], number, true
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBucketDurations(t *testing.T) {
+	durations := []time.Duration{
+		30 * time.Minute,
+		12 * time.Hour,
+		3 * 24 * time.Hour,
+		20 * 24 * time.Hour,
+		60 * 24 * time.Hour,
+	}
+
+	h := bucketDurations(durations)
+
+	want := map[string]int{"<1h": 1, "<1d": 1, "<1w": 1, "<30d": 1, ">=30d": 1}
+	if len(h.Buckets) != len(want) {
+		t.Fatalf("got %d buckets, want %d", len(h.Buckets), len(want))
+	}
+	for _, b := range h.Buckets {
+		if b.Count != want[b.Label] {
+			t.Errorf("bucket %q = %d, want %d", b.Label, b.Count, want[b.Label])
+		}
+	}
+}
+
+func TestParseIssueURL(t *testing.T) {
+	owner, repo, number, ok := parseIssueURL("https://github.com/vipul-rawat/gh-summary/issues/42")
+	if !ok || owner != "vipul-rawat" || repo != "gh-summary" || number != 42 {
+		t.Errorf("got (%q, %q, %d, %v), want (\"vipul-rawat\", \"gh-summary\", 42, true)", owner, repo, number, ok)
+	}
+
+	owner, repo, number, ok = parseIssueURL("https://github.com/vipul-rawat/gh-summary/pull/7")
+	if !ok || owner != "vipul-rawat" || repo != "gh-summary" || number != 7 {
+		t.Errorf("got (%q, %q, %d, %v), want (\"vipul-rawat\", \"gh-summary\", 7, true)", owner, repo, number, ok)
+	}
+
+	if _, _, _, ok := parseIssueURL("not a url"); ok {
+		t.Error("parseIssueURL(\"not a url\") = ok, want !ok")
+	}
+}
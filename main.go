@@ -2,13 +2,11 @@ package main
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"os"
 	"time"
 
-	"github.com/google/go-github/v59/github"
 	"gofr.dev/pkg/gofr"
-	"golang.org/x/oauth2"
 )
 
 const (
@@ -26,190 +24,105 @@ type Response struct {
 
 // Activity struct to represent a single activity (issue, PR, commit, or comment)
 type Activity struct {
-	Title string `json:"title"`
-	URL   string `json:"url"`
+	Title       string `json:"title"`
+	URL         string `json:"url"`
+	Source      string `json:"source"`
+	Status      string `json:"status,omitempty"`
+	StatusClass string `json:"status_class,omitempty"`
 }
 
 func main() {
 	app := gofr.NewCMD()
 
-	githubToken := app.Config.Get("GITHUB_TOKEN")
 	githubUser := app.Config.Get("GITHUB_USER")
 
 	ctx := context.Background()
-	ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: githubToken})
-	tc := oauth2.NewClient(ctx, ts)
-	client := github.NewClient(tc)
 
 	app.SubCommand("fetch", func(c *gofr.Context) (interface{}, error) {
-		dateStr := c.Param("date")
-		date, err := time.Parse(dateFormat, dateStr)
+		out, err := newOutput(c.Param("format"), c.Param("html-template"))
 		if err != nil {
-			return nil, fmt.Errorf("invalid date format: %v", err)
+			return nil, err
 		}
 
-		// Channels to collect results
-		issuesCh := make(chan []Activity)
-		prsReviewedCh := make(chan []Activity)
-		prsMergedCh := make(chan []Activity)
-		commitsCh := make(chan []Activity)
-		commentsCh := make(chan []Activity)
-
-		go func() {
-			issuesCh <- fetchIssuesCreated(ctx, client, githubUser, date)
-		}()
-		go func() {
-			prsReviewedCh <- fetchPRsReviewed(ctx, client, githubUser, date)
-		}()
-		go func() {
-			prsMergedCh <- fetchPRsMerged(ctx, client, githubUser, date)
-		}()
-		go func() {
-			commitsCh <- fetchCommitsCreated(ctx, client, githubUser, date)
-		}()
-		go func() {
-			commentsCh <- fetchComments(ctx, client, githubUser, date)
-		}()
-
-		// Collect results from channels
-		response := Response{
-			IssuesCreated:  <-issuesCh,
-			PRsReviewed:    <-prsReviewedCh,
-			PRsMerged:      <-prsMergedCh,
-			CommitsCreated: <-commitsCh,
-			Comments:       <-commentsCh,
+		var response Response
+
+		if fromStr, toStr := c.Param("from"), c.Param("to"); fromStr != "" || toStr != "" {
+			if fromStr == "" || toStr == "" {
+				return nil, fmt.Errorf("--from and --to must be given together")
+			}
+
+			from, err := time.Parse(dateFormat, fromStr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid --from date: %v", err)
+			}
+
+			to, err := time.Parse(dateFormat, toStr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid --to date: %v", err)
+			}
+
+			response, err = fetchRange(ctx, app.Config, githubUser, from, to)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			dateStr := c.Param("date")
+			date, err := time.Parse(dateFormat, dateStr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid date format: %v", err)
+			}
+
+			specs := c.Params("forge")
+			if len(specs) == 0 {
+				specs = []string{"github"}
+			}
+
+			var forges []Forge
+			for _, spec := range specs {
+				forge, err := newForge(ctx, spec, app.Config)
+				if err != nil {
+					return nil, err
+				}
+				forges = append(forges, forge)
+			}
+
+			response = fetchAll(ctx, forges, githubUser, date)
 		}
 
-		// Close channels (good practice after receiving from them)
-		close(issuesCh)
-		close(prsReviewedCh)
-		close(prsMergedCh)
-		close(commitsCh)
-		close(commentsCh)
+		if err := out.Render(os.Stdout, response); err != nil {
+			return nil, fmt.Errorf("rendering output: %v", err)
+		}
 
-		prettyPrintResponse(response)
+		if err := postToMastodon(ctx, app.Config, response); err != nil {
+			fmt.Printf("Error posting to Mastodon: %v\n", err)
+		}
 
 		return nil, nil
 	})
 
-	app.Run()
-}
-
-func prettyPrintResponse(resp Response) {
-	prettyJSON, err := json.MarshalIndent(resp, "", "    ")
-	if err != nil {
-		fmt.Println("Failed to generate JSON:", err)
-		return
-	}
-	fmt.Println(string(prettyJSON))
-}
-
-func fetchIssuesCreated(ctx context.Context, client *github.Client, user string, date time.Time) []Activity {
-	opts := &github.SearchOptions{Sort: "created", Order: "desc"}
-	query := fmt.Sprintf("author:%s type:issue created:%s", user, date.Format("2006-01-02"))
-	results, _, err := client.Search.Issues(ctx, query, opts)
-	if err != nil {
-		fmt.Printf("Error fetching issues: %v\n", err)
-		return nil
-	}
-
-	var activities []Activity
-	for _, issue := range results.Issues {
-		activities = append(activities, Activity{
-			Title: issue.GetTitle(),
-			URL:   issue.GetHTMLURL(),
-		})
-	}
-
-	return activities
-}
-
-func fetchPRsReviewed(ctx context.Context, client *github.Client, user string, date time.Time) []Activity {
-	opts := &github.SearchOptions{Sort: "updated", Order: "desc"}
-	query := fmt.Sprintf("reviewed-by:%s type:pr updated:%s", user, date.Format("2006-01-02"))
-	results, _, err := client.Search.Issues(ctx, query, opts)
-	if err != nil {
-		fmt.Printf("Error fetching PRs reviewed: %v\n", err)
-		return nil
-	}
-
-	var activities []Activity
-	for _, pr := range results.Issues {
-		activities = append(activities, Activity{
-			Title: pr.GetTitle(),
-			URL:   pr.GetHTMLURL(),
-		})
-	}
-
-	return activities
-}
-
-func fetchPRsMerged(ctx context.Context, client *github.Client, user string, date time.Time) []Activity {
-	opts := &github.SearchOptions{Sort: "updated", Order: "desc"}
-	query := fmt.Sprintf("author:%s type:pr is:merged updated:%s", user, date.Format("2006-01-02"))
-	results, _, err := client.Search.Issues(ctx, query, opts)
-	if err != nil {
-		fmt.Printf("Error fetching merged PRs: %v\n", err)
-		return nil
-	}
-
-	var activities []Activity
-	for _, pr := range results.Issues {
-		activities = append(activities, Activity{
-			Title: pr.GetTitle(),
-			URL:   pr.GetHTMLURL(),
-		})
-	}
-
-	return activities
-}
+	app.SubCommand("group", func(c *gofr.Context) (interface{}, error) {
+		dateStr := c.Param("date")
+		date, err := time.Parse(dateFormat, dateStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date format: %v", err)
+		}
 
-func fetchCommitsCreated(ctx context.Context, client *github.Client, user string, date time.Time) []Activity {
-	repos, _, err := client.Repositories.List(ctx, user, nil)
-	if err != nil {
-		fmt.Printf("Error fetching repositories: %v\n", err)
-		return nil
-	}
-
-	var activities []Activity
-	for _, repo := range repos {
-		commits, _, err := client.Repositories.ListCommits(ctx, repo.GetOwner().GetLogin(), repo.GetName(), &github.CommitsListOptions{
-			Author: user,
-			Since:  date,
-			Until:  date.Add(24 * time.Hour),
-		})
+		client := githubClient(ctx, app.Config.Get("GITHUB_TOKEN"))
 
+		users, err := resolveGroupMembers(ctx, client, c.Param("org"), c.Param("users"), c.Param("members-file"))
 		if err != nil {
-			continue
+			return nil, err
 		}
 
-		for _, commit := range commits {
-			activities = append(activities, Activity{
-				Title: commit.GetCommit().GetMessage(),
-				URL:   commit.GetHTMLURL(),
-			})
+		group, err := fetchGroup(ctx, client, users, date, c.Param("gerrit-project"))
+		if err != nil {
+			return nil, err
 		}
-	}
 
-	return activities
-}
+		prettyPrintJSON(group)
+
+		return nil, nil
+	})
 
-func fetchComments(ctx context.Context, client *github.Client, user string, date time.Time) []Activity {
-	opts := &github.SearchOptions{Sort: "updated", Order: "desc"}
-	query := fmt.Sprintf("commenter:%s updated:%s", user, date.Format("2006-01-02"))
-	results, _, err := client.Search.Issues(ctx, query, opts)
-	if err != nil {
-		fmt.Printf("Error fetching comments: %v\n", err)
-		return nil
-	}
-
-	var activities []Activity
-	for _, issue := range results.Issues {
-		activities = append(activities, Activity{
-			Title: issue.GetTitle(),
-			URL:   issue.GetHTMLURL(),
-		})
-	}
-
-	return activities
+	app.Run()
 }
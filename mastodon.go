@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mattn/go-mastodon"
+)
+
+// postToMastodon publishes resp as a thread of toots, one per category, when
+// MASTODON_INSTANCE and MASTODON_TOKEN are configured. It's meant to be wired
+// into a daily cron so "what I worked on today" publishes itself.
+func postToMastodon(ctx context.Context, cfg configGetter, resp Response) error {
+	instance := cfg.Get("MASTODON_INSTANCE")
+	token := cfg.Get("MASTODON_TOKEN")
+	if instance == "" || token == "" {
+		return nil
+	}
+
+	client := mastodon.NewClient(&mastodon.Config{
+		Server:      instance,
+		AccessToken: token,
+	})
+
+	sections := []struct {
+		heading    string
+		activities []Activity
+	}{
+		{"Issues created today", resp.IssuesCreated},
+		{"PRs reviewed today", resp.PRsReviewed},
+		{"PRs merged today", resp.PRsMerged},
+		{"Commits today", resp.CommitsCreated},
+		{"Comments today", resp.Comments},
+	}
+
+	var replyTo *mastodon.ID
+	for _, section := range sections {
+		if len(section.activities) == 0 {
+			continue
+		}
+
+		status, err := client.PostStatus(ctx, &mastodon.Toot{
+			Status:      tootText(section.heading, section.activities),
+			InReplyToID: derefID(replyTo),
+		})
+		if err != nil {
+			return fmt.Errorf("posting %q toot: %w", section.heading, err)
+		}
+
+		replyTo = &status.ID
+	}
+
+	return nil
+}
+
+func tootText(heading string, activities []Activity) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s\n\n", heading)
+	for _, a := range activities {
+		fmt.Fprintf(&b, "%s\n%s\n\n", a.Title, a.URL)
+	}
+	return strings.TrimSpace(b.String())
+}
+
+func derefID(id *mastodon.ID) mastodon.ID {
+	if id == nil {
+		return ""
+	}
+	return *id
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// member is one entry of a --members-file YAML list, e.g.:
+//
+//	- login: alice
+//	  name: Alice Liddell
+type member struct {
+	Login string `yaml:"login"`
+	Name  string `yaml:"name"`
+}
+
+// resolveGroupMembers turns the group subcommand's --org/--users/--members-file
+// flags into a flat list of GitHub logins.
+func resolveGroupMembers(ctx context.Context, client *Client, org, usersCSV, membersFile string) ([]string, error) {
+	switch {
+	case org != "":
+		members, err := client.ListOrgMembers(ctx, org, nil)
+		if err != nil {
+			return nil, fmt.Errorf("listing members of %s: %w", org, err)
+		}
+
+		var logins []string
+		for _, m := range members {
+			logins = append(logins, m.GetLogin())
+		}
+
+		return logins, nil
+
+	case membersFile != "":
+		data, err := os.ReadFile(membersFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", membersFile, err)
+		}
+
+		var members []member
+		if err := yaml.Unmarshal(data, &members); err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", membersFile, err)
+		}
+
+		var logins []string
+		for _, m := range members {
+			logins = append(logins, m.Login)
+		}
+
+		return logins, nil
+
+	case usersCSV != "":
+		var logins []string
+		for _, u := range strings.Split(usersCSV, ",") {
+			if u = strings.TrimSpace(u); u != "" {
+				logins = append(logins, u)
+			}
+		}
+
+		return logins, nil
+
+	default:
+		return nil, fmt.Errorf("group requires one of --org, --users, or --members-file")
+	}
+}
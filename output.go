@@ -0,0 +1,32 @@
+package main
+
+import "io"
+
+// Output renders a Response to w in a particular format, selected by the
+// fetch subcommand's --format flag.
+type Output interface {
+	Render(w io.Writer, resp Response) error
+}
+
+// newOutput resolves a --format flag value to its Output implementation.
+// An empty format defaults to "json" to preserve the tool's original behavior.
+func newOutput(format, htmlTemplatePath string) (Output, error) {
+	switch format {
+	case "", "json":
+		return jsonOutput{}, nil
+	case "markdown":
+		return markdownOutput{}, nil
+	case "html":
+		return newHTMLOutput(htmlTemplatePath)
+	case "atom":
+		return atomOutput{}, nil
+	default:
+		return nil, unknownFormatError{format}
+	}
+}
+
+type unknownFormatError struct{ format string }
+
+func (e unknownFormatError) Error() string {
+	return "unknown --format " + e.format + " (want json, markdown, html, or atom)"
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+)
+
+// atomOutput renders a Response as an Atom 1.0 feed, one <entry> per
+// Activity, so the summary can be subscribed to instead of pulled.
+type atomOutput struct{}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomEntry struct {
+	Title   string `xml:"title"`
+	ID      string `xml:"id"`
+	Link    atomLink
+	Summary string `xml:"summary"`
+}
+
+type atomLink struct {
+	XMLName xml.Name `xml:"link"`
+	Href    string   `xml:"href,attr"`
+}
+
+func (atomOutput) Render(w io.Writer, resp Response) error {
+	feed := atomFeed{
+		Title: "gh-summary activity",
+		ID:    "urn:gh-summary:feed",
+	}
+
+	categories := []struct {
+		name       string
+		activities []Activity
+	}{
+		{"issue created", resp.IssuesCreated},
+		{"PR reviewed", resp.PRsReviewed},
+		{"PR merged", resp.PRsMerged},
+		{"commit", resp.CommitsCreated},
+		{"comment", resp.Comments},
+	}
+
+	for _, category := range categories {
+		for _, a := range category.activities {
+			summary := category.name
+			if a.Status != "" {
+				summary = fmt.Sprintf("%s (%s)", summary, a.Status)
+			}
+
+			feed.Entries = append(feed.Entries, atomEntry{
+				Title:   a.Title,
+				ID:      a.URL,
+				Link:    atomLink{Href: a.URL},
+				Summary: summary,
+			})
+		}
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(feed); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
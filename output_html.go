@@ -0,0 +1,39 @@
+package main
+
+import (
+	_ "embed"
+	"html/template"
+	"io"
+)
+
+//go:embed templates/contributions.html.tmpl
+var defaultHTMLTemplate string
+
+// htmlOutput renders a Response through an html/template, defaulting to the
+// embedded contributions page but overridable via --html-template. Activity
+// titles and URLs come straight from GitHub issue/PR/commit content, so
+// html/template (which escapes by context) is required here, not text/template.
+type htmlOutput struct {
+	tmpl *template.Template
+}
+
+func newHTMLOutput(path string) (*htmlOutput, error) {
+	if path == "" {
+		tmpl, err := template.New("contributions").Parse(defaultHTMLTemplate)
+		if err != nil {
+			return nil, err
+		}
+		return &htmlOutput{tmpl: tmpl}, nil
+	}
+
+	tmpl, err := template.ParseFiles(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &htmlOutput{tmpl: tmpl}, nil
+}
+
+func (o *htmlOutput) Render(w io.Writer, resp Response) error {
+	return o.tmpl.Execute(w, resp)
+}
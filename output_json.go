@@ -0,0 +1,32 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// jsonOutput renders a Response as indented JSON, matching the tool's
+// original (and still default) output format.
+type jsonOutput struct{}
+
+func (jsonOutput) Render(w io.Writer, resp Response) error {
+	data, err := json.MarshalIndent(resp, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+
+// prettyPrintJSON marshals any value as indented JSON to stdout; used by
+// subcommands like "group" that don't (yet) go through the Output interface.
+func prettyPrintJSON(v interface{}) {
+	data, err := json.MarshalIndent(v, "", "    ")
+	if err != nil {
+		fmt.Println("Failed to generate JSON:", err)
+		return
+	}
+	fmt.Println(string(data))
+}
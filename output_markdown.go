@@ -0,0 +1,49 @@
+package main
+
+import (
+	"fmt"
+	"io"
+)
+
+// markdownOutput renders a Response as grouped "- [title](url)" sections,
+// suitable for pasting into a standup doc or a weekly blog post.
+type markdownOutput struct{}
+
+func (markdownOutput) Render(w io.Writer, resp Response) error {
+	sections := []struct {
+		heading    string
+		activities []Activity
+	}{
+		{"Issues Created", resp.IssuesCreated},
+		{"PRs Reviewed", resp.PRsReviewed},
+		{"PRs Merged", resp.PRsMerged},
+		{"Commits", resp.CommitsCreated},
+		{"Comments", resp.Comments},
+	}
+
+	for _, section := range sections {
+		if len(section.activities) == 0 {
+			continue
+		}
+
+		if _, err := fmt.Fprintf(w, "## %s\n\n", section.heading); err != nil {
+			return err
+		}
+
+		for _, a := range section.activities {
+			line := fmt.Sprintf("- [%s](%s)", a.Title, a.URL)
+			if a.Status != "" {
+				line += fmt.Sprintf(" _(%s)_", a.Status)
+			}
+			if _, err := fmt.Fprintln(w, line); err != nil {
+				return err
+			}
+		}
+
+		if _, err := fmt.Fprintln(w); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
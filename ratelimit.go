@@ -0,0 +1,327 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/go-github/v59/github"
+	"golang.org/x/sync/semaphore"
+)
+
+// Default concurrency budgets. GitHub rate-limits the search and core REST
+// endpoints independently, so each gets its own semaphore.
+const (
+	defaultSearchConcurrency = 2
+	defaultCoreConcurrency   = 8
+
+	// lowRateThreshold is how many requests of budget must remain before
+	// ratelimit.Client starts sleeping until Rate.Reset instead of calling through.
+	lowRateThreshold = 2
+
+	maxRetries = 5
+)
+
+// Client wraps a *github.Client so every call through it paginates to
+// completion, backs off on abuse/rate-limit errors, and throttles itself
+// before it ever gets a 403 by watching github.Response.Rate.
+type Client struct {
+	*github.Client
+
+	search *semaphore.Weighted
+	core   *semaphore.Weighted
+}
+
+// NewClient wraps gh with the default search/core concurrency budgets.
+func NewClient(gh *github.Client) *Client {
+	return &Client{
+		Client: gh,
+		search: semaphore.NewWeighted(defaultSearchConcurrency),
+		core:   semaphore.NewWeighted(defaultCoreConcurrency),
+	}
+}
+
+// call runs fn under the given budget's semaphore, retrying on abuse/rate-limit
+// errors with exponential backoff (honoring Retry-After when present), and
+// proactively sleeping until Rate.Reset whenever a response reports it's
+// nearly out of budget.
+func (c *Client) call(ctx context.Context, budget *semaphore.Weighted, fn func() (*github.Response, error)) error {
+	if err := budget.Acquire(ctx, 1); err != nil {
+		return err
+	}
+	defer budget.Release(1)
+
+	var lastErr error
+
+	for attempt := 0; attempt < maxRetries; attempt++ {
+		resp, err := fn()
+
+		if resp != nil && resp.Rate.Remaining <= lowRateThreshold && !resp.Rate.Reset.IsZero() {
+			sleepUntil(ctx, resp.Rate.Reset.Time)
+		}
+
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+
+		var abuseErr *github.AbuseRateLimitError
+		if errors.As(err, &abuseErr) {
+			wait := backoff(attempt)
+			if abuseErr.RetryAfter != nil {
+				wait = *abuseErr.RetryAfter
+			}
+			sleepFor(ctx, wait)
+			continue
+		}
+
+		var rateErr *github.RateLimitError
+		if errors.As(err, &rateErr) {
+			sleepUntil(ctx, rateErr.Rate.Reset.Time)
+			continue
+		}
+
+		return err
+	}
+
+	return fmt.Errorf("giving up after %d attempts: %w", maxRetries, lastErr)
+}
+
+func backoff(attempt int) time.Duration {
+	return time.Duration(math.Pow(2, float64(attempt))) * time.Second
+}
+
+func sleepFor(ctx context.Context, d time.Duration) {
+	select {
+	case <-time.After(d):
+	case <-ctx.Done():
+	}
+}
+
+func sleepUntil(ctx context.Context, t time.Time) {
+	if d := time.Until(t); d > 0 {
+		sleepFor(ctx, d)
+	}
+}
+
+// SearchIssues runs client.Search.Issues under the search budget, paginating
+// until every page of results has been collected.
+func (c *Client) SearchIssues(ctx context.Context, query string, opts *github.SearchOptions) (*github.IssuesSearchResult, error) {
+	if opts == nil {
+		opts = &github.SearchOptions{}
+	}
+	opts.PerPage = 100
+
+	result := &github.IssuesSearchResult{}
+
+	for {
+		var page *github.IssuesSearchResult
+		var resp *github.Response
+
+		err := c.call(ctx, c.search, func() (*github.Response, error) {
+			var err error
+			page, resp, err = c.Search.Issues(ctx, query, opts)
+			return resp, err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		result.Issues = append(result.Issues, page.Issues...)
+		result.Total = page.Total
+		result.IncompleteResults = page.IncompleteResults
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return result, nil
+}
+
+// ListCommits runs client.Repositories.ListCommits under the core budget,
+// paginating until every page of commits has been collected.
+func (c *Client) ListCommits(ctx context.Context, owner, repo string, opts *github.CommitsListOptions) ([]*github.RepositoryCommit, error) {
+	if opts == nil {
+		opts = &github.CommitsListOptions{}
+	}
+	opts.PerPage = 100
+
+	var all []*github.RepositoryCommit
+
+	for {
+		var page []*github.RepositoryCommit
+		var resp *github.Response
+
+		err := c.call(ctx, c.core, func() (*github.Response, error) {
+			var err error
+			page, resp, err = c.Repositories.ListCommits(ctx, owner, repo, opts)
+			return resp, err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, page...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return all, nil
+}
+
+// ListRepositories runs client.Repositories.List under the core budget,
+// paginating until every page of repositories has been collected. This is
+// the list call chunk0-6 specifically calls out: a user with more than one
+// page of repos previously lost commit coverage silently past page one.
+func (c *Client) ListRepositories(ctx context.Context, user string, opts *github.RepositoryListOptions) ([]*github.Repository, error) {
+	if opts == nil {
+		opts = &github.RepositoryListOptions{}
+	}
+	opts.PerPage = 100
+
+	var all []*github.Repository
+
+	for {
+		var page []*github.Repository
+		var resp *github.Response
+
+		err := c.call(ctx, c.core, func() (*github.Response, error) {
+			var err error
+			page, resp, err = c.Repositories.List(ctx, user, opts)
+			return resp, err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, page...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return all, nil
+}
+
+// GetPullRequest runs client.PullRequests.Get under the core budget.
+func (c *Client) GetPullRequest(ctx context.Context, owner, repo string, number int) (*github.PullRequest, error) {
+	var pr *github.PullRequest
+
+	err := c.call(ctx, c.core, func() (*github.Response, error) {
+		var resp *github.Response
+		var err error
+		pr, resp, err = c.PullRequests.Get(ctx, owner, repo, number)
+		return resp, err
+	})
+
+	return pr, err
+}
+
+// GetIssue runs client.Issues.Get under the core budget.
+func (c *Client) GetIssue(ctx context.Context, owner, repo string, number int) (*github.Issue, error) {
+	var issue *github.Issue
+
+	err := c.call(ctx, c.core, func() (*github.Response, error) {
+		var resp *github.Response
+		var err error
+		issue, resp, err = c.Issues.Get(ctx, owner, repo, number)
+		return resp, err
+	})
+
+	return issue, err
+}
+
+// ListReviews runs client.PullRequests.ListReviews under the core budget,
+// paginating until every page of reviews has been collected.
+func (c *Client) ListReviews(ctx context.Context, owner, repo string, number int, opts *github.ListOptions) ([]*github.PullRequestReview, error) {
+	if opts == nil {
+		opts = &github.ListOptions{}
+	}
+	opts.PerPage = 100
+
+	var all []*github.PullRequestReview
+
+	for {
+		var page []*github.PullRequestReview
+		var resp *github.Response
+
+		err := c.call(ctx, c.core, func() (*github.Response, error) {
+			var err error
+			page, resp, err = c.PullRequests.ListReviews(ctx, owner, repo, number, opts)
+			return resp, err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, page...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return all, nil
+}
+
+// ListEventsPerformedByUser runs client.Activity.ListEventsPerformedByUser
+// under the core budget. Unlike the other List* wrappers this doesn't
+// paginate to completion itself: corpus.Refresh stops paging as soon as it
+// sees an event older than its `since` cutoff, so it drives paging one page
+// at a time via the returned *github.Response.
+func (c *Client) ListEventsPerformedByUser(ctx context.Context, user string, publicOnly bool, opts *github.ListOptions) ([]*github.Event, *github.Response, error) {
+	var events []*github.Event
+	var resp *github.Response
+
+	err := c.call(ctx, c.core, func() (*github.Response, error) {
+		var err error
+		events, resp, err = c.Activity.ListEventsPerformedByUser(ctx, user, publicOnly, opts)
+		return resp, err
+	})
+
+	return events, resp, err
+}
+
+// ListOrgMembers runs client.Organizations.ListMembers under the core
+// budget, paginating until every page of members has been collected.
+func (c *Client) ListOrgMembers(ctx context.Context, org string, opts *github.ListMembersOptions) ([]*github.User, error) {
+	if opts == nil {
+		opts = &github.ListMembersOptions{}
+	}
+	opts.PerPage = 100
+
+	var all []*github.User
+
+	for {
+		var page []*github.User
+		var resp *github.Response
+
+		err := c.call(ctx, c.core, func() (*github.Response, error) {
+			var err error
+			page, resp, err = c.Organizations.ListMembers(ctx, org, opts)
+			return resp, err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, page...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return all, nil
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/mod/semver"
+)
+
+// Status values for Activity.Status.
+const (
+	StatusOpen     = "open"
+	StatusClosed   = "closed"
+	StatusMerged   = "merged"
+	releasedPrefix = "released:"
+)
+
+// releaseStatus resolves whether sha has shipped in a tagged release of
+// owner/repo, returning "released:vX.Y.Z" for the lowest containing tag, or
+// StatusMerged if the commit hasn't reached a tag yet.
+func releaseStatus(ctx context.Context, owner, repo, sha string) string {
+	mirror, err := mirrorRepo(ctx, owner, repo)
+	if err != nil {
+		fmt.Printf("Error mirroring %s/%s: %v\n", owner, repo, err)
+		return StatusMerged
+	}
+
+	tag, err := lowestTagContaining(ctx, mirror, sha)
+	if err != nil || tag == "" {
+		return StatusMerged
+	}
+
+	return releasedPrefix + tag
+}
+
+// statusClass maps a Status to a short class suitable for templating, e.g.
+// coloring a badge in the markdown/HTML renderers.
+func statusClass(status string) string {
+	switch {
+	case strings.HasPrefix(status, releasedPrefix):
+		return "released"
+	default:
+		return status
+	}
+}
+
+// mirrorState is the memoized result of syncing one owner/repo mirror: the
+// clone/fetch runs at most once per process, via once, and every other
+// caller (including concurrent forges/group-mode goroutines resolving
+// commits in the same repo) blocks on once and then reuses path/err.
+type mirrorState struct {
+	once sync.Once
+	path string
+	err  error
+}
+
+var mirrors sync.Map // map[string]*mirrorState, keyed by "owner/repo"
+
+// mirrorRepo lazily `git clone --bare`s owner/repo under
+// $XDG_CACHE_HOME/gh-summary/git/<owner>/<repo>.git, fetching new refs once
+// per run if the mirror already exists, and returns its local path. Safe for
+// concurrent callers: the actual clone/fetch for a given owner/repo happens
+// exactly once, regardless of how many commits or goroutines ask for it.
+func mirrorRepo(ctx context.Context, owner, repo string) (string, error) {
+	key := owner + "/" + repo
+
+	actual, _ := mirrors.LoadOrStore(key, &mirrorState{})
+	state := actual.(*mirrorState)
+
+	state.once.Do(func() {
+		state.path, state.err = syncMirror(ctx, owner, repo)
+	})
+
+	return state.path, state.err
+}
+
+// syncMirror performs the actual clone-or-fetch; see mirrorRepo for the
+// memoization that keeps this to one call per owner/repo per run.
+func syncMirror(ctx context.Context, owner, repo string) (string, error) {
+	dir, err := cacheDir("git")
+	if err != nil {
+		return "", err
+	}
+
+	mirror := filepath.Join(dir, owner, repo+".git")
+
+	if _, err := os.Stat(mirror); os.IsNotExist(err) {
+		url := fmt.Sprintf("https://github.com/%s/%s.git", owner, repo)
+		cmd := exec.CommandContext(ctx, "git", "clone", "--bare", url, mirror)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return "", fmt.Errorf("git clone --bare: %w: %s", err, out)
+		}
+		return mirror, nil
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "--git-dir", mirror, "fetch", "--tags", "origin")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return "", fmt.Errorf("git fetch: %w: %s", err, out)
+	}
+
+	return mirror, nil
+}
+
+// lowestTagContaining returns the lowest semver tag (by `git tag --contains`)
+// that reachably contains sha, or "" if sha isn't in any tag yet.
+func lowestTagContaining(ctx context.Context, mirror, sha string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", "--git-dir", mirror, "tag", "--contains", sha, "--sort=-v:refname")
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("git tag --contains: %w", err)
+	}
+
+	var tags []string
+	for _, tag := range strings.Fields(string(out)) {
+		if semver.IsValid(tag) || semver.IsValid("v"+tag) {
+			tags = append(tags, tag)
+		}
+	}
+	if len(tags) == 0 {
+		return "", nil
+	}
+
+	sort.Slice(tags, func(i, j int) bool {
+		vi, vj := tags[i], tags[j]
+		if !strings.HasPrefix(vi, "v") {
+			vi = "v" + vi
+		}
+		if !strings.HasPrefix(vj, "v") {
+			vj = "v" + vj
+		}
+		return semver.Compare(vi, vj) < 0
+	})
+
+	return tags[0], nil
+}
+
+// mergeCommitSHA fetches the merge commit SHA for a merged pull request.
+func mergeCommitSHA(ctx context.Context, client *Client, owner, repo string, number int) (string, error) {
+	pr, err := client.GetPullRequest(ctx, owner, repo, number)
+	if err != nil {
+		return "", err
+	}
+	return pr.GetMergeCommitSHA(), nil
+}
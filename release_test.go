@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+// initTestRepo creates a throwaway git repo with one commit tagged with every
+// tag in tags, returning the repo dir and the commit's SHA.
+func initTestRepo(t *testing.T, tags ...string) (dir, sha string) {
+	t.Helper()
+	dir = t.TempDir()
+
+	run := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+
+	run("init", "-q")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "test")
+	run("commit", "-q", "--allow-empty", "-m", "initial")
+
+	for _, tag := range tags {
+		run("tag", tag)
+	}
+
+	out, err := exec.Command("git", "-C", dir, "rev-parse", "HEAD").Output()
+	if err != nil {
+		t.Fatalf("rev-parse HEAD: %v", err)
+	}
+
+	return dir, strings.TrimSpace(string(out))
+}
+
+func TestLowestTagContaining(t *testing.T) {
+	dir, sha := initTestRepo(t, "v2.0.0", "v1.0.0", "v1.5.0")
+
+	got, err := lowestTagContaining(context.Background(), dir+"/.git", sha)
+	if err != nil {
+		t.Fatalf("lowestTagContaining: %v", err)
+	}
+	if got != "v1.0.0" {
+		t.Errorf("lowestTagContaining = %q, want %q", got, "v1.0.0")
+	}
+}
+
+func TestLowestTagContainingNoTags(t *testing.T) {
+	dir, sha := initTestRepo(t)
+
+	got, err := lowestTagContaining(context.Background(), dir+"/.git", sha)
+	if err != nil {
+		t.Fatalf("lowestTagContaining: %v", err)
+	}
+	if got != "" {
+		t.Errorf("lowestTagContaining = %q, want empty", got)
+	}
+}